@@ -3,36 +3,48 @@ package quicktick
 import (
 	"context"
 	"sync"
-	"sync/atomic"
 	"time"
 )
 
 type QuickTick struct {
-	multiplier      float64
-	tickerInterval  time.Duration
-	startTime       time.Time
-	startRealTime   time.Time
-	currentDuration int64
-	done            chan struct{}
-	mu              sync.Mutex
-	once            sync.Once
+	multiplier     float64
+	tickerInterval time.Duration
+	startTime      time.Time
+	startRealTime  time.Time
+	createdAt      time.Time
+	// baseDuration is the accelerated duration accumulated as of
+	// startRealTime. It moves forward when freezeLocked re-anchors the
+	// clock from Pause or SetMultiplier; Reset sets it back to zero and
+	// Restore replaces it.
+	baseDuration int64
+	paused       bool
+	done         chan struct{}
+	mu           sync.Mutex
+	once         sync.Once
+
+	// schedMu, schedOnce, waiters, and wake back the accelerated-time
+	// Timer/Ticker scheduler. See schedulerLoop in timer.go.
+	schedMu   sync.Mutex
+	schedOnce sync.Once
+	waiters   waiterHeap
+	wake      chan struct{}
 }
 
 // Create a new QuickTick clock with the given multiplier.
 //
-// Uses the current time and updates each Millisecond. For more customizability use the NewCustom function.
+// Uses the current time as the starting point. For more customizability use the NewCustom function.
 func New(multiplier float64) *QuickTick {
 	startTime := time.Now()
 
 	ac := &QuickTick{
-		multiplier:      multiplier,
-		tickerInterval:  time.Millisecond,
-		startTime:       startTime,
-		startRealTime:   startTime,
-		currentDuration: 0,
-		done:            make(chan struct{}),
+		multiplier:     multiplier,
+		tickerInterval: time.Millisecond,
+		startTime:      startTime,
+		startRealTime:  startTime,
+		createdAt:      startTime,
+		done:           make(chan struct{}),
+		wake:           make(chan struct{}, 1),
 	}
-	go ac.run()
 	return ac
 }
 
@@ -41,42 +53,45 @@ func New(multiplier float64) *QuickTick {
 // Parameters:
 //   - startTime: The initial time from which the accelerated time will be calculated.
 //   - multiplier: The rate at which the accelerated time progresses relative to real time. For example, a multiplier of 2.0 means the accelerated time runs twice as fast as real time.
-//   - updateInterval: The interval at which the clock will update. This allows for customization of how frequently the clock recalculates the accelerated time.
+//   - updateInterval: Kept for API compatibility. Now computes the accelerated time on demand rather than from a periodic updater, so this no longer affects anything.
 //
 // Example Usage:
 //
 //	startTime := time.Now()
 //	multiplier := 1.5 // Time runs 1.5 times faster
-//	updateInterval := 500 * time.Millisecond // Update the clock every 500 milliseconds
+//	updateInterval := 500 * time.Millisecond
 //	clock := quicktick.NewCustom(startTime, multiplier, updateInterval)
 //
-// Use this function if you need to customize the clock's update frequency and starting time.
+// Use this function if you need to customize the clock's starting time.
 func NewCustom(startTime time.Time, multiplier float64, updateInterval time.Duration) *QuickTick {
+	now := time.Now()
+
 	ac := &QuickTick{
-		multiplier:      multiplier,
-		tickerInterval:  updateInterval,
-		startTime:       startTime,
-		startRealTime:   time.Now(),
-		currentDuration: 0,
-		done:            make(chan struct{}),
+		multiplier:     multiplier,
+		tickerInterval: updateInterval,
+		startTime:      startTime,
+		startRealTime:  now,
+		createdAt:      now,
+		done:           make(chan struct{}),
+		wake:           make(chan struct{}, 1),
 	}
-	go ac.run()
 	return ac
 }
 
 // Create a new QuickTick clock with the given context and multiplier.
 //
-// Uses the current time and updates each Millisecond. For more customizability use the NewCustomCtx function.
+// Uses the current time as the starting point. For more customizability use the NewCustomCtx function.
 func NewCtx(ctx context.Context, multiplier float64) *QuickTick {
 	startTime := time.Now()
 
 	ac := &QuickTick{
-		multiplier:      multiplier,
-		tickerInterval:  time.Millisecond,
-		startTime:       startTime,
-		startRealTime:   startTime,
-		currentDuration: 0,
-		done:            make(chan struct{}),
+		multiplier:     multiplier,
+		tickerInterval: time.Millisecond,
+		startTime:      startTime,
+		startRealTime:  startTime,
+		createdAt:      startTime,
+		done:           make(chan struct{}),
+		wake:           make(chan struct{}, 1),
 	}
 	go ac.runWithContext(ctx)
 	return ac
@@ -85,10 +100,10 @@ func NewCtx(ctx context.Context, multiplier float64) *QuickTick {
 // NewCustomCtx creates a new QuickTick clock starting at the given startTime, with the specified context, multiplier, and updateInterval.
 //
 // Parameters:
-//   - ctx: The context to control the lifecycle of the clock. The clock will stop updating when the context is done.
+//   - ctx: The context to control the lifecycle of the clock. The clock will stop when ctx is done.
 //   - startTime: The initial time from which the accelerated time will be calculated.
 //   - multiplier: The rate at which the accelerated time progresses relative to real time. For example, a multiplier of 2.0 means the accelerated time runs twice as fast as real time.
-//   - updateInterval: The interval at which the clock will update. This allows for customization of how frequently the clock recalculates the accelerated time.
+//   - updateInterval: Kept for API compatibility. Now computes the accelerated time on demand rather than from a periodic updater, so this no longer affects anything.
 //
 // Example Usage:
 //
@@ -96,83 +111,98 @@ func NewCtx(ctx context.Context, multiplier float64) *QuickTick {
 //	defer cancel()
 //	startTime := time.Now()
 //	multiplier := 1.5 // Time runs 1.5 times faster
-//	updateInterval := 500 * time.Millisecond // Update the clock every 500 milliseconds
+//	updateInterval := 500 * time.Millisecond
 //	clock := quicktick.NewCustomCtx(ctx, startTime, multiplier, updateInterval)
 //
-// Use this function if you need to customize the clock's update frequency and starting time, and also want to control the clock's lifecycle with a context.
+// Use this function if you need to customize the clock's starting time and control its lifecycle with a context.
 func NewCustomCtx(ctx context.Context, startTime time.Time, multiplier float64, updateInterval time.Duration) *QuickTick {
+	now := time.Now()
+
 	ac := &QuickTick{
-		multiplier:      multiplier,
-		tickerInterval:  updateInterval,
-		startTime:       startTime,
-		startRealTime:   time.Now(),
-		currentDuration: 0,
-		done:            make(chan struct{}),
+		multiplier:     multiplier,
+		tickerInterval: updateInterval,
+		startTime:      startTime,
+		startRealTime:  now,
+		createdAt:      now,
+		done:           make(chan struct{}),
+		wake:           make(chan struct{}, 1),
 	}
 	go ac.runWithContext(ctx)
 	return ac
 }
 
-// Start clock
-func (ac *QuickTick) run() {
-	ticker := time.NewTicker(time.Millisecond)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			ac.updateClock()
-		case <-ac.done:
-			return
-		}
+// runWithContext stops the clock once ctx is done. Accelerated time itself
+// is computed on demand by Now, so this goroutine has nothing to do but
+// watch for cancellation; timers and tickers are driven by their own
+// schedulerLoop goroutine (see timer.go).
+func (ac *QuickTick) runWithContext(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		ac.Stop()
+	case <-ac.done:
 	}
 }
 
-// Start clock with context
-func (ac *QuickTick) runWithContext(ctx context.Context) {
-	ticker := time.NewTicker(time.Millisecond)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			ac.updateClock()
-		case <-ctx.Done():
-			ac.Stop()
-			return
-		case <-ac.done:
-			return
-		}
-	}
+// Now returns the current accelerated time.
+func (ac *QuickTick) Now() time.Time {
+	return ac.NowMonotonic()
 }
 
-func (ac *QuickTick) updateClock() {
+// NowMonotonic returns the current accelerated time by computing it
+// directly from startRealTime and the multiplier, rather than reading a
+// value last written by a periodic updater. Unlike a ticker-driven Now,
+// it can't go stale if a background goroutine is delayed or never runs.
+func (ac *QuickTick) NowMonotonic() time.Time {
 	ac.mu.Lock()
-	elapsedRealTime := time.Since(ac.startRealTime)
+	base := ac.baseDuration
+	if !ac.paused {
+		base += int64(time.Since(ac.startRealTime).Seconds() * ac.multiplier * float64(time.Second))
+	}
 	ac.mu.Unlock()
-	acceleratedElapsedTime := elapsedRealTime.Seconds() * ac.multiplier
-	atomic.StoreInt64(&ac.currentDuration, int64(acceleratedElapsedTime*float64(time.Second)))
+
+	return ac.startTime.Add(time.Duration(base))
 }
 
-// Now returns the current accelerated time.
-func (ac *QuickTick) Now() time.Time {
-	currentDuration := atomic.LoadInt64(&ac.currentDuration)
-	accumulatedDuration := time.Duration(currentDuration)
-	acceleratedTime := ac.startTime.Add(accumulatedDuration)
-	return acceleratedTime
+// Elapsed returns the accelerated duration elapsed since the clock's
+// startTime.
+func (ac *QuickTick) Elapsed() time.Duration {
+	return ac.NowMonotonic().Sub(ac.startTime)
+}
+
+// RealElapsed returns the real, wall-clock duration elapsed since the
+// clock was created, independent of Pause/Resume/SetMultiplier/Reset.
+func (ac *QuickTick) RealElapsed() time.Duration {
+	return time.Since(ac.createdAt)
 }
 
-// Stop stops the QuickTick clock.
+// Stop stops the QuickTick clock. Any pending Timers and Tickers are
+// cancelled and their channels closed.
 func (ac *QuickTick) Stop() {
 	ac.once.Do(func() {
 		close(ac.done)
+
+		ac.schedMu.Lock()
+		for _, w := range ac.waiters {
+			if w.c != nil {
+				close(w.c)
+			}
+			w.index = -1
+		}
+		ac.waiters = nil
+		ac.schedMu.Unlock()
 	})
 }
 
-// Reset resets the QuickTick clock.
+// Reset resets the QuickTick clock. Pending Timers and Tickers are shifted
+// so that the accelerated duration remaining until they fire is unaffected
+// by the reset.
 func (ac *QuickTick) Reset() {
+	oldNow := ac.Now()
+
 	ac.mu.Lock()
-	defer ac.mu.Unlock()
 	ac.startRealTime = time.Now()
-	atomic.StoreInt64(&ac.currentDuration, 0)
+	ac.baseDuration = 0
+	ac.mu.Unlock()
+
+	ac.shiftWaiters(ac.Now().Sub(oldNow))
 }
@@ -0,0 +1,74 @@
+package quicktick
+
+import "time"
+
+// Clock is the interface implemented by QuickTick and FakeClock. Code that
+// needs to read or wait on time should depend on Clock instead of a
+// concrete type so that tests can swap in a FakeClock and drive time
+// deterministically instead of sleeping on the wall clock.
+type Clock interface {
+	// Now returns the current time of the clock.
+	Now() time.Time
+
+	// Since returns the time elapsed since t, as measured by the clock.
+	Since(t time.Time) time.Duration
+
+	// After returns a channel that receives the current time once d has
+	// elapsed on the clock.
+	After(d time.Duration) <-chan time.Time
+
+	// NewTicker returns a Ticker that fires repeatedly every d.
+	NewTicker(d time.Duration) Ticker
+
+	// NewTimer returns a Timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+
+	// Sleep blocks until d has elapsed on the clock.
+	Sleep(d time.Duration)
+
+	// AfterFunc waits for d to elapse and then calls f in its own
+	// goroutine. It returns a Timer that can be used to cancel the call.
+	AfterFunc(d time.Duration, f func()) Timer
+
+	// Stop stops the clock.
+	Stop()
+
+	// Reset resets the clock.
+	Reset()
+}
+
+// Ticker mirrors time.Ticker as an interface so that it can be backed by
+// either the real QuickTick clock or a FakeClock.
+type Ticker interface {
+	// C returns the channel on which ticks are delivered.
+	C() <-chan time.Time
+
+	// Stop turns off the ticker. It does not close the channel.
+	Stop()
+
+	// Reset stops the ticker and resets its period to d.
+	Reset(d time.Duration)
+}
+
+// Timer mirrors time.Timer as an interface so that it can be backed by
+// either the real QuickTick clock or a FakeClock.
+type Timer interface {
+	// C returns the channel on which the timer fires. It is nil for
+	// timers created with AfterFunc.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. It returns true if the call
+	// stops the timer, false if the timer has already expired or been
+	// stopped.
+	Stop() bool
+
+	// Reset changes the timer to expire after d. It returns true if the
+	// timer had been active, false if it had expired or been stopped.
+	Reset(d time.Duration) bool
+}
+
+// Compile-time checks that QuickTick and FakeClock satisfy Clock.
+var (
+	_ Clock = (*QuickTick)(nil)
+	_ Clock = (*FakeClock)(nil)
+)
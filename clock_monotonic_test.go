@@ -0,0 +1,44 @@
+package quicktick
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNowMonotonicMatchesNow(t *testing.T) {
+	clock := New(multiplier)
+	defer clock.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+
+	diff := clock.Now().Sub(clock.NowMonotonic())
+	if diff > 10*time.Millisecond || diff < -10*time.Millisecond {
+		t.Errorf("Now and NowMonotonic diverged by %v", diff)
+	}
+}
+
+func TestElapsedTracksMultiplier(t *testing.T) {
+	clock := New(2)
+	defer clock.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+
+	elapsed := clock.Elapsed().Seconds()
+	want := clock.RealElapsed().Seconds() * 2
+
+	if elapsed < want-tolerance || elapsed > want+tolerance {
+		t.Errorf("Elapsed = %v, want approximately %v", elapsed, want)
+	}
+}
+
+func TestRealElapsedIgnoresMultiplier(t *testing.T) {
+	clock := New(1000)
+	defer clock.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+
+	real := clock.RealElapsed().Seconds()
+	if real < 0.2-tolerance || real > 0.2+tolerance {
+		t.Errorf("RealElapsed = %v, want approximately 0.2s regardless of multiplier", real)
+	}
+}
@@ -0,0 +1,229 @@
+package quicktick
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// FakeClock is a Clock whose time only moves when Advance or SetNow is
+// called. It is intended for unit tests that need to exercise
+// time-dependent code deterministically, without sleeping on the wall
+// clock.
+//
+// FakeClock maintains a min-heap of pending waiters (timers, tickers,
+// After channels, and AfterFunc callbacks) keyed by the virtual time at
+// which they fire. Advancing the clock fires or reschedules every waiter
+// whose deadline has been reached, in chronological order.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	initial time.Time
+	waiters waiterHeap
+}
+
+// NewFakeClock returns a FakeClock set to the current wall-clock time.
+func NewFakeClock() *FakeClock {
+	return NewFakeClockAt(time.Now())
+}
+
+// NewFakeClockAt returns a FakeClock set to t.
+func NewFakeClockAt(t time.Time) *FakeClock {
+	return &FakeClock{now: t, initial: t}
+}
+
+// Now returns the current time of the FakeClock.
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+// Since returns the time elapsed since t, as measured by the FakeClock.
+func (fc *FakeClock) Since(t time.Time) time.Duration {
+	return fc.Now().Sub(t)
+}
+
+// Advance moves the FakeClock forward by d, firing any waiters whose
+// deadline falls at or before the new time, in chronological order.
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	target := fc.now.Add(d)
+	fc.mu.Unlock()
+	fc.advanceTo(target)
+}
+
+// SetNow moves the FakeClock to t, firing any waiters whose deadline
+// falls at or before t, in chronological order. Calling SetNow with a
+// time before the current time only moves the clock backward; it does
+// not fire or unfire waiters.
+func (fc *FakeClock) SetNow(t time.Time) {
+	fc.mu.Lock()
+	if t.Before(fc.now) {
+		fc.now = t
+		fc.mu.Unlock()
+		return
+	}
+	fc.mu.Unlock()
+	fc.advanceTo(t)
+}
+
+// advanceTo fires every waiter due at or before target, in chronological
+// order, then advances now to target. It never holds fc.mu while
+// delivering to a channel or invoking a callback, so that FakeClock can
+// safely be advanced from the same goroutine that receives from a waiter.
+func (fc *FakeClock) advanceTo(target time.Time) {
+	for {
+		fc.mu.Lock()
+		if fc.waiters.Len() == 0 || fc.waiters[0].deadline.After(target) {
+			if fc.now.Before(target) {
+				fc.now = target
+			}
+			fc.mu.Unlock()
+			return
+		}
+
+		w := heap.Pop(&fc.waiters).(*waiter)
+		w.index = -1
+		fc.now = w.deadline
+		deadline := w.deadline
+		fc.mu.Unlock()
+
+		switch {
+		case w.fn != nil:
+			go w.fn()
+		case w.c != nil:
+			select {
+			case w.c <- deadline:
+			default:
+			}
+		}
+
+		if w.period > 0 {
+			fc.mu.Lock()
+			w.deadline = fc.now.Add(w.period)
+			heap.Push(&fc.waiters, w)
+			fc.mu.Unlock()
+		}
+	}
+}
+
+// Sleep blocks until d has elapsed on the FakeClock.
+func (fc *FakeClock) Sleep(d time.Duration) {
+	<-fc.After(d)
+}
+
+// After returns a channel that receives the current time once d has
+// elapsed on the FakeClock.
+func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
+	return fc.newTimer(d, 0, nil).c
+}
+
+// AfterFunc waits for d to elapse on the FakeClock and then calls f in
+// its own goroutine. It returns a Timer that can be used to cancel the
+// call.
+func (fc *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	w := fc.newTimer(d, 0, f)
+	return &fakeTimer{fc: fc, w: w}
+}
+
+// NewTimer creates a Timer that fires once after d has elapsed on the
+// FakeClock.
+func (fc *FakeClock) NewTimer(d time.Duration) Timer {
+	return &fakeTimer{fc: fc, w: fc.newTimer(d, 0, nil)}
+}
+
+// NewTicker creates a Ticker that fires repeatedly every d on the
+// FakeClock.
+func (fc *FakeClock) NewTicker(d time.Duration) Ticker {
+	return &fakeTicker{fc: fc, w: fc.newTimer(d, d, nil)}
+}
+
+// Stop is a no-op on FakeClock; it exists to satisfy the Clock interface.
+func (fc *FakeClock) Stop() {}
+
+// Reset moves the FakeClock back to the time it was created with. Pending
+// waiters are shifted so that the duration remaining until they fire is
+// unaffected by the reset, mirroring QuickTick.Reset.
+func (fc *FakeClock) Reset() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	delta := fc.initial.Sub(fc.now)
+	fc.now = fc.initial
+	for _, w := range fc.waiters {
+		w.deadline = w.deadline.Add(delta)
+	}
+}
+
+// newTimer schedules a waiter to fire after d, re-arming every period if
+// period is non-zero, and returns it.
+func (fc *FakeClock) newTimer(d, period time.Duration, fn func()) *waiter {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	w := &waiter{deadline: fc.now.Add(d), period: period, fn: fn}
+	if fn == nil {
+		w.c = make(chan time.Time, 1)
+	}
+	heap.Push(&fc.waiters, w)
+	return w
+}
+
+// stopWaiter removes w from the heap if it is still pending. It reports
+// whether w was pending.
+func (fc *FakeClock) stopWaiter(w *waiter) bool {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	if w.index < 0 {
+		return false
+	}
+	heap.Remove(&fc.waiters, w.index)
+	w.index = -1
+	return true
+}
+
+// resetWaiter reschedules w to fire after d (re-arming every period if
+// non-zero) and reports whether w was pending beforehand.
+func (fc *FakeClock) resetWaiter(w *waiter, d, period time.Duration) bool {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	active := w.index >= 0
+	if active {
+		heap.Remove(&fc.waiters, w.index)
+	}
+	w.deadline = fc.now.Add(d)
+	w.period = period
+	heap.Push(&fc.waiters, w)
+	return active
+}
+
+// fakeTimer implements Timer on top of a FakeClock.
+type fakeTimer struct {
+	fc *FakeClock
+	w  *waiter
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.w.c }
+
+func (t *fakeTimer) Stop() bool { return t.fc.stopWaiter(t.w) }
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	return t.fc.resetWaiter(t.w, d, 0)
+}
+
+// fakeTicker implements Ticker on top of a FakeClock.
+type fakeTicker struct {
+	fc *FakeClock
+	w  *waiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time { return t.w.c }
+
+func (t *fakeTicker) Stop() { t.fc.stopWaiter(t.w) }
+
+func (t *fakeTicker) Reset(d time.Duration) {
+	t.fc.resetWaiter(t.w, d, d)
+}
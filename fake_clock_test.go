@@ -0,0 +1,132 @@
+package quicktick
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClockAfter(t *testing.T) {
+	clock := NewFakeClockAt(time.Unix(0, 0))
+
+	ch := clock.After(time.Second)
+
+	select {
+	case <-ch:
+		t.Fatal("After fired before the clock was advanced")
+	default:
+	}
+
+	clock.Advance(time.Second)
+
+	select {
+	case got := <-ch:
+		want := time.Unix(1, 0)
+		if !got.Equal(want) {
+			t.Errorf("After delivered %v, want %v", got, want)
+		}
+	default:
+		t.Fatal("After did not fire after the clock was advanced")
+	}
+}
+
+func TestFakeClockNewTicker(t *testing.T) {
+	clock := NewFakeClockAt(time.Unix(0, 0))
+
+	ticker := clock.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for i := 1; i <= 3; i++ {
+		clock.Advance(time.Second)
+
+		select {
+		case got := <-ticker.C():
+			want := time.Unix(int64(i), 0)
+			if !got.Equal(want) {
+				t.Errorf("tick %d delivered %v, want %v", i, got, want)
+			}
+		default:
+			t.Fatalf("tick %d did not fire", i)
+		}
+	}
+}
+
+func TestFakeClockNewTimerReset(t *testing.T) {
+	clock := NewFakeClockAt(time.Unix(0, 0))
+
+	timer := clock.NewTimer(time.Second)
+
+	if !timer.Reset(2 * time.Second) {
+		t.Fatal("Reset reported the timer as inactive before it fired")
+	}
+
+	clock.Advance(time.Second)
+
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its reset deadline")
+	default:
+	}
+
+	clock.Advance(time.Second)
+
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("timer did not fire after its reset deadline")
+	}
+}
+
+func TestFakeClockStopPreventsFire(t *testing.T) {
+	clock := NewFakeClockAt(time.Unix(0, 0))
+
+	timer := clock.NewTimer(time.Second)
+	if !timer.Stop() {
+		t.Fatal("Stop reported the timer as already fired")
+	}
+
+	clock.Advance(time.Second)
+
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired after being stopped")
+	default:
+	}
+}
+
+func TestFakeClockResetShiftsPendingTimer(t *testing.T) {
+	clock := NewFakeClockAt(time.Unix(0, 0))
+
+	timer := clock.NewTimer(time.Second)
+
+	clock.Advance(400 * time.Millisecond)
+	clock.Reset()
+
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired on Reset, expected it to keep its remaining duration")
+	default:
+	}
+
+	clock.Advance(600 * time.Millisecond)
+
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("timer did not fire after Reset once its shifted deadline was reached")
+	}
+}
+
+func TestFakeClockAfterFunc(t *testing.T) {
+	clock := NewFakeClockAt(time.Unix(0, 0))
+
+	done := make(chan struct{})
+	clock.AfterFunc(time.Second, func() { close(done) })
+
+	clock.Advance(time.Second)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("AfterFunc callback was not invoked")
+	}
+}
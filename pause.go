@@ -0,0 +1,59 @@
+package quicktick
+
+import "time"
+
+// Pause freezes the accelerated clock. While paused, Now returns a fixed
+// value and pending Timers/Tickers stop counting down. Pause is a no-op
+// if the clock is already paused.
+func (ac *QuickTick) Pause() {
+	ac.mu.Lock()
+	if ac.paused {
+		ac.mu.Unlock()
+		return
+	}
+	ac.freezeLocked()
+	ac.paused = true
+	ac.mu.Unlock()
+
+	ac.wakeScheduler()
+}
+
+// Resume unfreezes a clock previously paused with Pause, continuing from
+// exactly the accelerated time it was paused at. Resume is a no-op if the
+// clock is not paused.
+func (ac *QuickTick) Resume() {
+	ac.mu.Lock()
+	if !ac.paused {
+		ac.mu.Unlock()
+		return
+	}
+	ac.startRealTime = time.Now()
+	ac.paused = false
+	ac.mu.Unlock()
+
+	ac.wakeScheduler()
+}
+
+// SetMultiplier changes the rate at which the accelerated clock runs
+// relative to real time, without any discontinuity in the accelerated
+// time already elapsed.
+func (ac *QuickTick) SetMultiplier(m float64) {
+	ac.mu.Lock()
+	ac.freezeLocked()
+	ac.multiplier = m
+	ac.mu.Unlock()
+
+	ac.wakeScheduler()
+}
+
+// freezeLocked folds the accelerated duration elapsed since startRealTime
+// into baseDuration and re-anchors startRealTime to now, so that a
+// subsequent change to multiplier or paused does not cause Now to jump.
+// ac.mu must be held.
+func (ac *QuickTick) freezeLocked() {
+	if !ac.paused {
+		elapsed := time.Since(ac.startRealTime).Seconds() * ac.multiplier
+		ac.baseDuration += int64(elapsed * float64(time.Second))
+	}
+	ac.startRealTime = time.Now()
+}
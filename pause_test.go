@@ -0,0 +1,87 @@
+package quicktick
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPauseFreezesNow(t *testing.T) {
+	clock := New(multiplier)
+	defer clock.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+	clock.Pause()
+
+	frozen := clock.Now()
+	time.Sleep(200 * time.Millisecond)
+
+	if !clock.Now().Equal(frozen) {
+		t.Errorf("Now changed while paused: got %v, want %v", clock.Now(), frozen)
+	}
+}
+
+func TestResumeContinuesFromPausePoint(t *testing.T) {
+	clock := New(multiplier)
+	defer clock.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+	clock.Pause()
+	frozen := clock.Now()
+
+	time.Sleep(200 * time.Millisecond)
+	clock.Resume()
+	time.Sleep(200 * time.Millisecond)
+
+	if clock.Now().Before(frozen) {
+		t.Errorf("Now went backwards after Resume: got %v, want >= %v", clock.Now(), frozen)
+	}
+}
+
+func TestSetMultiplierHasNoDiscontinuity(t *testing.T) {
+	clock := New(1)
+	defer clock.Stop()
+
+	time.Sleep(200 * time.Millisecond)
+	before := clock.Now()
+
+	clock.SetMultiplier(100)
+
+	after := clock.Now()
+	if after.Sub(before) > 50*time.Millisecond || before.Sub(after) > 50*time.Millisecond {
+		t.Errorf("SetMultiplier caused a jump in Now: before %v, after %v", before, after)
+	}
+}
+
+func TestSetMultiplierWakesPendingTicker(t *testing.T) {
+	clock := New(1)
+	defer clock.Stop()
+
+	ticker := clock.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	clock.SetMultiplier(1000) // 1s virtual now == 1ms real
+
+	start := time.Now()
+	<-ticker.C()
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("tick arrived after %v, expected the scheduler to wake and fire within a few ms", elapsed)
+	}
+}
+
+func TestResumeWakesPendingTimer(t *testing.T) {
+	clock := New(1000)
+	defer clock.Stop()
+
+	clock.Pause()
+	timer := clock.NewTimer(time.Second) // 1ms real at this multiplier, but clock is paused
+
+	time.Sleep(100 * time.Millisecond)
+
+	start := time.Now()
+	clock.Resume()
+	<-timer.C()
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("timer fired %v after Resume, expected it to fire within a few ms", elapsed)
+	}
+}
@@ -0,0 +1,42 @@
+package quicktick
+
+import "time"
+
+// waiter is a single scheduled timer, ticker, After channel, or AfterFunc
+// callback, ordered in a waiterHeap by deadline. It backs both QuickTick's
+// accelerated-time scheduler and FakeClock.
+type waiter struct {
+	deadline time.Time
+	period   time.Duration // non-zero for tickers, which re-arm on fire
+	c        chan time.Time
+	fn       func()
+	index    int
+}
+
+// waiterHeap is a container/heap of *waiter ordered by deadline.
+type waiterHeap []*waiter
+
+func (h waiterHeap) Len() int { return len(h) }
+
+func (h waiterHeap) Less(i, j int) bool { return h[i].deadline.Before(h[j].deadline) }
+
+func (h waiterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *waiterHeap) Push(x any) {
+	w := x.(*waiter)
+	w.index = len(*h)
+	*h = append(*h, w)
+}
+
+func (h *waiterHeap) Pop() any {
+	old := *h
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return w
+}
@@ -0,0 +1,122 @@
+package quicktick
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// State is a point-in-time snapshot of a QuickTick clock, suitable for
+// persisting to disk or sending to another process.
+type State struct {
+	StartTime       time.Time     `json:"startTime"`
+	StartRealTime   time.Time     `json:"startRealTime"`
+	Multiplier      float64       `json:"multiplier"`
+	CurrentDuration time.Duration `json:"currentDuration"`
+	TickerInterval  time.Duration `json:"tickerInterval"`
+	Paused          bool          `json:"paused"`
+}
+
+// Snapshot captures the current state of the clock.
+func (ac *QuickTick) Snapshot() State {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	duration := ac.baseDuration
+	if !ac.paused {
+		duration += int64(time.Since(ac.startRealTime).Seconds() * ac.multiplier * float64(time.Second))
+	}
+
+	return State{
+		StartTime:       ac.startTime,
+		StartRealTime:   ac.startRealTime,
+		Multiplier:      ac.multiplier,
+		CurrentDuration: time.Duration(duration),
+		TickerInterval:  ac.tickerInterval,
+		Paused:          ac.paused,
+	}
+}
+
+// Restore replaces the clock's state with s, re-anchoring startRealTime to
+// now so that the accelerated time resumes from exactly where s left off.
+// Pending Timers and Tickers are not part of the snapshot and are left
+// untouched. If the clock had been stopped with Stop, Restore lets the
+// scheduler goroutine start again, so Timers and Tickers created after
+// Restore fire.
+func (ac *QuickTick) Restore(s State) {
+	ac.mu.Lock()
+	ac.startTime = s.StartTime
+	ac.multiplier = s.Multiplier
+	ac.tickerInterval = s.TickerInterval
+	ac.baseDuration = int64(s.CurrentDuration)
+	ac.paused = s.Paused
+	ac.startRealTime = time.Now()
+
+	stopped := ac.done != nil && isClosed(ac.done)
+	if stopped {
+		ac.once = sync.Once{}
+		ac.schedOnce = sync.Once{}
+		ac.done = make(chan struct{})
+	}
+	ac.mu.Unlock()
+
+	if ac.createdAt.IsZero() {
+		ac.createdAt = ac.startRealTime
+	}
+	if ac.done == nil {
+		ac.done = make(chan struct{})
+	}
+	if ac.wake == nil {
+		ac.wake = make(chan struct{}, 1)
+	}
+
+	ac.wakeScheduler()
+}
+
+// isClosed reports whether c has been closed.
+func isClosed(c chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding a Snapshot of the clock.
+func (ac *QuickTick) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ac.Snapshot())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, Restoring the clock from a
+// previously marshalled Snapshot.
+func (ac *QuickTick) UnmarshalJSON(data []byte) error {
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	ac.Restore(s)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder, encoding a Snapshot of the clock.
+func (ac *QuickTick) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(ac.Snapshot()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, Restoring the clock from a
+// previously encoded Snapshot.
+func (ac *QuickTick) GobDecode(data []byte) error {
+	var s State
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return err
+	}
+	ac.Restore(s)
+	return nil
+}
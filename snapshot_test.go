@@ -0,0 +1,94 @@
+package quicktick
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRestorePreservesElapsed(t *testing.T) {
+	clock := New(10)
+	defer clock.Stop()
+
+	time.Sleep(100 * time.Millisecond)
+	before := clock.Now()
+	snapshot := clock.Snapshot()
+
+	restored := New(10)
+	defer restored.Stop()
+	restored.Restore(snapshot)
+
+	if diff := restored.Now().Sub(before); diff < -50*time.Millisecond || diff > 50*time.Millisecond {
+		t.Errorf("restored clock diverged from snapshot by %v", diff)
+	}
+}
+
+func TestRestoreRelaunchesSchedulerAfterStop(t *testing.T) {
+	clock := New(10)
+	snapshot := clock.Snapshot()
+	clock.Stop()
+
+	clock.Restore(snapshot)
+	defer clock.Stop()
+
+	timer := clock.NewTimer(100 * time.Millisecond) // 10ms real at 10x
+
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Error("timer never fired after Restore following Stop")
+	}
+}
+
+func TestQuickTickJSONRoundTrip(t *testing.T) {
+	clock := New(5)
+	defer clock.Stop()
+
+	time.Sleep(100 * time.Millisecond)
+	before := clock.Now()
+
+	data, err := json.Marshal(clock)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var restored QuickTick
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	defer restored.Stop()
+
+	if diff := restored.Now().Sub(before); diff < -50*time.Millisecond || diff > 50*time.Millisecond {
+		t.Errorf("restored clock diverged from original by %v", diff)
+	}
+}
+
+func TestQuickTickGobRoundTrip(t *testing.T) {
+	clock := New(5)
+	defer clock.Stop()
+	clock.Pause()
+	paused := clock.Now()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(clock); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	var restored QuickTick
+	if err := gob.NewDecoder(&buf).Decode(&restored); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	defer restored.Stop()
+
+	if !restored.Now().Equal(paused) {
+		t.Errorf("restored paused clock = %v, want %v", restored.Now(), paused)
+	}
+
+	restored.Resume()
+	time.Sleep(50 * time.Millisecond)
+	if !restored.Now().After(paused) {
+		t.Error("restored clock did not resume counting after Resume")
+	}
+}
@@ -0,0 +1,23 @@
+package quicktick
+
+import "time"
+
+// NewTicker creates a Ticker that fires repeatedly every d on the
+// accelerated timeline.
+func (ac *QuickTick) NewTicker(d time.Duration) Ticker {
+	return &quickTicker{ac: ac, w: ac.schedule(d, d, nil)}
+}
+
+// quickTicker implements Ticker on top of a QuickTick's scheduler.
+type quickTicker struct {
+	ac *QuickTick
+	w  *waiter
+}
+
+func (qt *quickTicker) C() <-chan time.Time { return qt.w.c }
+
+func (qt *quickTicker) Stop() { qt.ac.stopWaiter(qt.w) }
+
+func (qt *quickTicker) Reset(d time.Duration) {
+	qt.ac.resetWaiter(qt.w, d, d)
+}
@@ -0,0 +1,208 @@
+package quicktick
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Since returns the accelerated time elapsed since t.
+func (ac *QuickTick) Since(t time.Time) time.Duration {
+	return ac.Now().Sub(t)
+}
+
+// Sleep pauses the current goroutine until d has elapsed on the
+// accelerated timeline.
+func (ac *QuickTick) Sleep(d time.Duration) {
+	<-ac.After(d)
+}
+
+// After waits for d to elapse on the accelerated timeline and then sends
+// the current time on the returned channel.
+func (ac *QuickTick) After(d time.Duration) <-chan time.Time {
+	return ac.NewTimer(d).C()
+}
+
+// AfterFunc waits for d to elapse on the accelerated timeline and then
+// calls f in its own goroutine. It returns a Timer that can be used to
+// cancel the call.
+func (ac *QuickTick) AfterFunc(d time.Duration, f func()) Timer {
+	return &quickTimer{ac: ac, w: ac.schedule(d, 0, f)}
+}
+
+// NewTimer creates a Timer that fires once after d has elapsed on the
+// accelerated timeline.
+func (ac *QuickTick) NewTimer(d time.Duration) Timer {
+	return &quickTimer{ac: ac, w: ac.schedule(d, 0, nil)}
+}
+
+// realDuration converts a duration on the accelerated timeline to the
+// equivalent real-time duration for this clock's multiplier.
+func (ac *QuickTick) realDuration(d time.Duration) time.Duration {
+	ac.mu.Lock()
+	multiplier := ac.multiplier
+	ac.mu.Unlock()
+
+	return time.Duration(float64(d) / multiplier)
+}
+
+// schedule queues a waiter to fire after d has elapsed on the accelerated
+// timeline, re-arming every period if period is non-zero, starting the
+// scheduler goroutine if this is the clock's first pending waiter.
+func (ac *QuickTick) schedule(d, period time.Duration, fn func()) *waiter {
+	ac.ensureScheduler()
+
+	ac.schedMu.Lock()
+	w := &waiter{deadline: ac.Now().Add(d), period: period, fn: fn}
+	if fn == nil {
+		w.c = make(chan time.Time, 1)
+	}
+	heap.Push(&ac.waiters, w)
+	ac.schedMu.Unlock()
+
+	ac.wakeScheduler()
+	return w
+}
+
+// stopWaiter removes w from the scheduler if it is still pending. It
+// reports whether w was pending.
+func (ac *QuickTick) stopWaiter(w *waiter) bool {
+	ac.schedMu.Lock()
+	defer ac.schedMu.Unlock()
+
+	if w.index < 0 {
+		return false
+	}
+	heap.Remove(&ac.waiters, w.index)
+	w.index = -1
+	return true
+}
+
+// resetWaiter reschedules w to fire after d (re-arming every period if
+// non-zero) and reports whether w was pending beforehand.
+func (ac *QuickTick) resetWaiter(w *waiter, d, period time.Duration) bool {
+	ac.schedMu.Lock()
+	active := w.index >= 0
+	if active {
+		heap.Remove(&ac.waiters, w.index)
+	}
+	w.deadline = ac.Now().Add(d)
+	w.period = period
+	heap.Push(&ac.waiters, w)
+	ac.schedMu.Unlock()
+
+	ac.wakeScheduler()
+	return active
+}
+
+// shiftWaiters adds delta to every pending waiter's deadline. It is used
+// by Reset to keep each waiter's remaining duration unaffected by a jump
+// in the accelerated timeline.
+func (ac *QuickTick) shiftWaiters(delta time.Duration) {
+	ac.schedMu.Lock()
+	for _, w := range ac.waiters {
+		w.deadline = w.deadline.Add(delta)
+	}
+	ac.schedMu.Unlock()
+
+	ac.wakeScheduler()
+}
+
+// ensureScheduler starts the goroutine that delivers pending timers and
+// tickers, if it isn't already running.
+func (ac *QuickTick) ensureScheduler() {
+	ac.schedOnce.Do(func() {
+		go ac.schedulerLoop()
+	})
+}
+
+// wakeScheduler nudges the scheduler goroutine into re-evaluating the
+// next deadline, e.g. after a waiter is added, reset, or shifted.
+func (ac *QuickTick) wakeScheduler() {
+	select {
+	case ac.wake <- struct{}{}:
+	default:
+	}
+}
+
+// schedulerLoop sleeps until the next pending waiter's deadline, expressed
+// as a real-time duration, then fires every waiter that has come due.
+func (ac *QuickTick) schedulerLoop() {
+	for {
+		ac.schedMu.Lock()
+		if ac.waiters.Len() == 0 {
+			ac.schedMu.Unlock()
+			select {
+			case <-ac.wake:
+				continue
+			case <-ac.done:
+				return
+			}
+		}
+		deadline := ac.waiters[0].deadline
+		ac.schedMu.Unlock()
+
+		remaining := ac.realDuration(deadline.Sub(ac.Now()))
+		if remaining <= 0 {
+			ac.fireDue()
+			continue
+		}
+
+		wait := time.NewTimer(remaining)
+		select {
+		case <-wait.C:
+			ac.fireDue()
+		case <-ac.wake:
+			wait.Stop()
+		case <-ac.done:
+			wait.Stop()
+			return
+		}
+	}
+}
+
+// fireDue delivers every pending waiter whose deadline has been reached,
+// re-arming tickers by adding their period.
+func (ac *QuickTick) fireDue() {
+	now := ac.Now()
+	for {
+		ac.schedMu.Lock()
+		if ac.waiters.Len() == 0 || ac.waiters[0].deadline.After(now) {
+			ac.schedMu.Unlock()
+			return
+		}
+		w := heap.Pop(&ac.waiters).(*waiter)
+		w.index = -1
+		ac.schedMu.Unlock()
+
+		switch {
+		case w.fn != nil:
+			go w.fn()
+		case w.c != nil:
+			select {
+			case w.c <- now:
+			default:
+			}
+		}
+
+		if w.period > 0 {
+			ac.schedMu.Lock()
+			w.deadline = now.Add(w.period)
+			heap.Push(&ac.waiters, w)
+			ac.schedMu.Unlock()
+		}
+	}
+}
+
+// quickTimer implements Timer on top of a QuickTick's scheduler.
+type quickTimer struct {
+	ac *QuickTick
+	w  *waiter
+}
+
+func (qt *quickTimer) C() <-chan time.Time { return qt.w.c }
+
+func (qt *quickTimer) Stop() bool { return qt.ac.stopWaiter(qt.w) }
+
+func (qt *quickTimer) Reset(d time.Duration) bool {
+	return qt.ac.resetWaiter(qt.w, d, 0)
+}
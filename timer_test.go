@@ -0,0 +1,70 @@
+package quicktick
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuickTickNewTimer(t *testing.T) {
+	clock := New(10)
+	defer clock.Stop()
+
+	start := time.Now()
+	timer := clock.NewTimer(time.Second) // 1s virtual == 100ms real at 10x
+	<-timer.C()
+	elapsed := time.Since(start)
+
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("NewTimer took %v, expected roughly 100ms", elapsed)
+	}
+}
+
+func TestQuickTickNewTicker(t *testing.T) {
+	clock := New(10)
+	defer clock.Stop()
+
+	ticker := clock.NewTicker(time.Second) // fires every ~100ms real
+	defer ticker.Stop()
+
+	start := time.Now()
+	<-ticker.C()
+	<-ticker.C()
+	elapsed := time.Since(start)
+
+	if elapsed > 1*time.Second {
+		t.Errorf("two ticks took %v, expected roughly 200ms", elapsed)
+	}
+}
+
+func TestQuickTickResetShiftsPendingTimer(t *testing.T) {
+	clock := New(10)
+	defer clock.Stop()
+
+	timer := clock.NewTimer(time.Second)
+
+	clock.Reset()
+
+	select {
+	case <-timer.C():
+		t.Error("timer fired immediately after Reset, expected it to keep its remaining duration")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	<-timer.C()
+}
+
+func TestQuickTickStopClosesTimerChannel(t *testing.T) {
+	clock := New(10)
+
+	timer := clock.NewTimer(time.Minute)
+	clock.Stop()
+
+	select {
+	case _, ok := <-timer.C():
+		if ok {
+			t.Error("expected timer channel to be closed, got a value")
+		}
+	case <-time.After(time.Second):
+		t.Error("timer channel was not closed after Stop")
+	}
+}